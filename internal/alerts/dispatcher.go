@@ -0,0 +1,191 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/expr-lang/expr/vm"
+	"golang.org/x/time/rate"
+
+	"github.com/validaoxyz/hyperliquid-exporter/internal/config"
+	"github.com/validaoxyz/hyperliquid-exporter/internal/history"
+	"github.com/validaoxyz/hyperliquid-exporter/internal/logger"
+)
+
+const (
+	maxSendAttempts = 3
+	retryBackoff    = 2 * time.Second
+
+	// dispatchQueueSize bounds how many undelivered events queue up
+	// behind a slow/rate-limited sink before Dispatch starts dropping
+	// new ones, so a stuck sink can't grow memory without bound.
+	dispatchQueueSize = 256
+)
+
+// route pairs a configured Sink with its compiled filter, rate limiter,
+// and its own delivery queue/worker, so a sink that is slow or tightly
+// rate-limited only ever blocks its own queue, never another sink's.
+type route struct {
+	sink    Sink
+	filter  *vm.Program
+	limiter *rate.Limiter
+	dryRun  bool
+	jobs    chan dispatchJob
+}
+
+type dispatchJob struct {
+	event history.Event
+	stake float64
+}
+
+// Dispatcher fans a detected event out to every configured sink whose
+// filter matches, retrying transient failures. Each sink delivers off a
+// dedicated background worker (see Start) with its own bounded queue, so
+// neither a slow/rate-limited sink nor the act of fanning out at all can
+// delay the validator poll that produced the event.
+type Dispatcher struct {
+	routes []route
+}
+
+// NewDispatcher builds a Dispatcher from the declarative sink list in
+// config.Config.Alerts. A sink with an invalid type or filter expression
+// is logged and skipped rather than failing the whole dispatcher, so a
+// typo in one sink's config doesn't take down alerting (or, since the
+// validator monitor wires this in at startup, metrics collection) for
+// every other sink.
+func NewDispatcher(cfg config.Config) *Dispatcher {
+	d := &Dispatcher{}
+
+	for _, sc := range cfg.Alerts.Sinks {
+		sink, err := buildSink(sc)
+		if err != nil {
+			logger.Error("Skipping misconfigured alert sink %q: %v", sc.Type, err)
+			continue
+		}
+
+		program, err := compileFilter(sc.Filter)
+		if err != nil {
+			logger.Error("Skipping alert sink %s with invalid filter: %v", sink.Name(), err)
+			continue
+		}
+
+		rps := sc.RateLimitPerSecond
+		if rps <= 0 {
+			rps = 1
+		}
+
+		d.routes = append(d.routes, route{
+			sink:    sink,
+			filter:  program,
+			limiter: rate.NewLimiter(rate.Limit(rps), 1),
+			dryRun:  sc.DryRun,
+			jobs:    make(chan dispatchJob, dispatchQueueSize),
+		})
+	}
+
+	return d
+}
+
+// Start runs one dispatch worker per route until ctx is cancelled. It
+// must be called once before Dispatch is used.
+func (d *Dispatcher) Start(ctx context.Context) {
+	if d == nil {
+		return
+	}
+
+	for i := range d.routes {
+		r := d.routes[i]
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case job := <-r.jobs:
+					deliverToRoute(ctx, r, job.event, job.stake)
+				}
+			}
+		}()
+	}
+}
+
+func buildSink(sc config.AlertSinkConfig) (Sink, error) {
+	switch sc.Type {
+	case "webhook":
+		return NewWebhookSink(sc.URL, sc.Secret), nil
+	case "slack":
+		return NewSlackSink(sc.URL), nil
+	case "pagerduty":
+		return NewPagerDutySink(sc.Secret), nil
+	default:
+		return nil, fmt.Errorf("unknown alert sink type %q", sc.Type)
+	}
+}
+
+// Dispatch evaluates event against every route's filter and enqueues it on
+// each matching route's own queue for that route's worker to deliver. It
+// never blocks the caller: if a route's queue is full (that sink is badly
+// stuck), the event is logged and dropped for that route only rather than
+// stalling the validator poll that produced it or any other route.
+func (d *Dispatcher) Dispatch(ctx context.Context, event history.Event, stake float64) {
+	if d == nil {
+		return
+	}
+
+	payload := Payload{Event: event, Stake: stake}
+
+	for _, r := range d.routes {
+		matched, err := matchesFilter(r.filter, payload)
+		if err != nil {
+			logger.Error("Alert filter error for sink %s: %v", r.sink.Name(), err)
+			continue
+		}
+		if !matched {
+			continue
+		}
+
+		select {
+		case r.jobs <- dispatchJob{event: event, stake: stake}:
+		default:
+			logger.Error("Alert dispatch queue full for sink %s, dropping %s event for validator %s", r.sink.Name(), event.Type, event.Validator)
+		}
+	}
+}
+
+// deliverToRoute sends payload to a single matched route, rate-limiting
+// and retrying as that route's worker goroutine. A dry-run route logs the
+// payload instead of sending it.
+func deliverToRoute(ctx context.Context, r route, event history.Event, stake float64) {
+	payload := Payload{Event: event, Stake: stake}
+
+	if r.dryRun {
+		logger.Info("Alert dry-run for sink %s: %+v", r.sink.Name(), payload)
+		return
+	}
+
+	if err := r.limiter.Wait(ctx); err != nil {
+		logger.Error("Alert rate limiter error for sink %s: %v", r.sink.Name(), err)
+		return
+	}
+
+	sendWithRetries(ctx, r.sink, payload)
+}
+
+func sendWithRetries(ctx context.Context, sink Sink, payload Payload) {
+	var lastErr error
+	for attempt := 1; attempt <= maxSendAttempts; attempt++ {
+		if err := sink.Send(ctx, payload); err != nil {
+			lastErr = err
+			logger.Error("Alert sink %s attempt %d/%d failed: %v", sink.Name(), attempt, maxSendAttempts, err)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(retryBackoff):
+			}
+			continue
+		}
+		return
+	}
+	logger.Error("Alert sink %s gave up after %d attempts: %v", sink.Name(), maxSendAttempts, lastErr)
+}
@@ -0,0 +1,132 @@
+package alerts
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/validaoxyz/hyperliquid-exporter/internal/config"
+	"github.com/validaoxyz/hyperliquid-exporter/internal/history"
+)
+
+func testEvent() history.Event {
+	return history.Event{
+		Validator: "0xvalidator",
+		Signer:    "0xsigner",
+		Name:      "test-validator",
+		Type:      history.EventJailed,
+		Before:    "false",
+		After:     "true",
+		Timestamp: time.Unix(0, 0).UTC(),
+	}
+}
+
+func newRecordingWebhookServer(t *testing.T, received chan<- struct{}) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- struct{}{}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func waitForSignal(t *testing.T, ch <-chan struct{}, want bool) {
+	t.Helper()
+	select {
+	case <-ch:
+		if !want {
+			t.Fatal("sink received a request, expected none")
+		}
+	case <-time.After(time.Second):
+		if want {
+			t.Fatal("timed out waiting for sink to receive a request")
+		}
+	}
+}
+
+func TestDispatcher_FansOutToEverySink(t *testing.T) {
+	recvA := make(chan struct{}, 1)
+	recvB := make(chan struct{}, 1)
+	serverA := newRecordingWebhookServer(t, recvA)
+	serverB := newRecordingWebhookServer(t, recvB)
+
+	d := NewDispatcher(config.Config{Alerts: config.AlertsConfig{Sinks: []config.AlertSinkConfig{
+		{Type: "webhook", URL: serverA.URL},
+		{Type: "webhook", URL: serverB.URL},
+	}}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d.Start(ctx)
+
+	d.Dispatch(ctx, testEvent(), 1_000_000)
+
+	waitForSignal(t, recvA, true)
+	waitForSignal(t, recvB, true)
+}
+
+func TestDispatcher_FilterExcludesNonMatchingSink(t *testing.T) {
+	recv := make(chan struct{}, 1)
+	server := newRecordingWebhookServer(t, recv)
+
+	d := NewDispatcher(config.Config{Alerts: config.AlertsConfig{Sinks: []config.AlertSinkConfig{
+		{Type: "webhook", URL: server.URL, Filter: `event == "unjailed"`},
+	}}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d.Start(ctx)
+
+	d.Dispatch(ctx, testEvent(), 1_000_000)
+	waitForSignal(t, recv, false)
+
+	unjailed := testEvent()
+	unjailed.Type = history.EventUnjailed
+	d.Dispatch(ctx, unjailed, 1_000_000)
+	waitForSignal(t, recv, true)
+}
+
+func TestDispatcher_DryRunDoesNotSend(t *testing.T) {
+	recv := make(chan struct{}, 1)
+	server := newRecordingWebhookServer(t, recv)
+
+	d := NewDispatcher(config.Config{Alerts: config.AlertsConfig{Sinks: []config.AlertSinkConfig{
+		{Type: "webhook", URL: server.URL, DryRun: true},
+	}}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d.Start(ctx)
+
+	d.Dispatch(ctx, testEvent(), 1_000_000)
+	waitForSignal(t, recv, false)
+}
+
+func TestDispatcher_SlowSinkDoesNotBlockOthers(t *testing.T) {
+	blockUntil := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-blockUntil
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer slow.Close()
+	defer close(blockUntil)
+
+	recvFast := make(chan struct{}, 1)
+	fast := newRecordingWebhookServer(t, recvFast)
+
+	d := NewDispatcher(config.Config{Alerts: config.AlertsConfig{Sinks: []config.AlertSinkConfig{
+		{Type: "webhook", URL: slow.URL},
+		{Type: "webhook", URL: fast.URL},
+	}}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d.Start(ctx)
+
+	d.Dispatch(ctx, testEvent(), 1_000_000)
+
+	waitForSignal(t, recvFast, true)
+}
@@ -0,0 +1,61 @@
+package alerts
+
+import (
+	"fmt"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+)
+
+// compileFilter parses an expression like `event in ["jailed", "left_set"]
+// and stake > 1000000` against a Payload-shaped environment. An empty
+// expression always matches.
+func compileFilter(expression string) (*vm.Program, error) {
+	if expression == "" {
+		return nil, nil
+	}
+
+	// The env declares every variable matchesFilter provides, with its
+	// type, so expressions referencing them type-check at compile time.
+	compileEnv := filterEnv{
+		"event":     "",
+		"validator": "",
+		"signer":    "",
+		"name":      "",
+		"stake":     0.0,
+	}
+	program, err := expr.Compile(expression, expr.Env(compileEnv), expr.AsBool())
+	if err != nil {
+		return nil, fmt.Errorf("compiling filter %q: %w", expression, err)
+	}
+	return program, nil
+}
+
+// filterEnv is the variable set exposed to filter expressions: a plain map
+// keyed by the lowercase names used in the filter syntax (`event`,
+// `validator`, `stake`, ...).
+type filterEnv map[string]any
+
+func matchesFilter(program *vm.Program, payload Payload) (bool, error) {
+	if program == nil {
+		return true, nil
+	}
+
+	env := filterEnv{
+		"event":     string(payload.Type),
+		"validator": payload.Validator,
+		"signer":    payload.Signer,
+		"name":      payload.Name,
+		"stake":     payload.Stake,
+	}
+
+	result, err := expr.Run(program, env)
+	if err != nil {
+		return false, fmt.Errorf("evaluating filter: %w", err)
+	}
+	matched, ok := result.(bool)
+	if !ok {
+		return false, fmt.Errorf("filter did not evaluate to a boolean")
+	}
+	return matched, nil
+}
@@ -0,0 +1,83 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const pagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// PagerDutySink triggers a PagerDuty Events API v2 incident. secret is the
+// integration's routing key.
+type PagerDutySink struct {
+	routingKey string
+	client     *http.Client
+
+	// pagerDutyEventsURLOverride lets tests point Send at an httptest
+	// server instead of the real PagerDuty Events API.
+	pagerDutyEventsURLOverride string
+}
+
+func NewPagerDutySink(routingKey string) *PagerDutySink {
+	return &PagerDutySink{routingKey: routingKey, client: &http.Client{Timeout: sinkRequestTimeout}}
+}
+
+func (s *PagerDutySink) Name() string {
+	return "pagerduty"
+}
+
+type pagerDutyEvent struct {
+	RoutingKey  string               `json:"routing_key"`
+	EventAction string               `json:"event_action"`
+	Payload     pagerDutyEventDetail `json:"payload"`
+}
+
+type pagerDutyEventDetail struct {
+	Summary       string `json:"summary"`
+	Source        string `json:"source"`
+	Severity      string `json:"severity"`
+	CustomDetails any    `json:"custom_details"`
+}
+
+func (s *PagerDutySink) Send(ctx context.Context, payload Payload) error {
+	event := pagerDutyEvent{
+		RoutingKey:  s.routingKey,
+		EventAction: "trigger",
+		Payload: pagerDutyEventDetail{
+			Summary:       fmt.Sprintf("validator %s (%s): %s", payload.Name, payload.Validator, payload.Type),
+			Source:        "hyperliquid-exporter",
+			Severity:      "warning",
+			CustomDetails: payload,
+		},
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshaling pagerduty event: %w", err)
+	}
+
+	url := pagerDutyEventsURL
+	if s.pagerDutyEventsURLOverride != "" {
+		url = s.pagerDutyEventsURLOverride
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating pagerduty request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending pagerduty event: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("pagerduty returned status %d", resp.StatusCode)
+	}
+	return nil
+}
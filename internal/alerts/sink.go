@@ -0,0 +1,34 @@
+// Package alerts fans detected validator events out to operator-configured
+// sinks (generic webhooks, Slack, PagerDuty), each gated by its own filter
+// expression and rate limit.
+package alerts
+
+import (
+	"context"
+	"time"
+
+	"github.com/validaoxyz/hyperliquid-exporter/internal/history"
+)
+
+// sinkRequestTimeout bounds every outbound sink request, matching the
+// httpx.FailoverClient's timeout for the same reason: a sink that accepts
+// the connection but never responds must not hang the caller.
+const sinkRequestTimeout = 10 * time.Second
+
+// Payload is what a Sink actually sends: the detected event plus the
+// context a notification needs to be self-contained (the validator's
+// current stake, for filter expressions like "stake > 1000000").
+type Payload struct {
+	history.Event
+	Stake float64
+}
+
+// Sink delivers a single alert payload to an external system.
+type Sink interface {
+	// Send delivers payload, returning an error if the destination
+	// rejected it or was unreachable. Dispatcher retries on error.
+	Send(ctx context.Context, payload Payload) error
+
+	// Name identifies the sink in logs (e.g. "webhook:https://...").
+	Name() string
+}
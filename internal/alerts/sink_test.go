@@ -0,0 +1,111 @@
+package alerts
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/validaoxyz/hyperliquid-exporter/internal/history"
+)
+
+func testPayload() Payload {
+	return Payload{
+		Event: history.Event{
+			Validator: "0xvalidator",
+			Signer:    "0xsigner",
+			Name:      "test-validator",
+			Type:      history.EventJailed,
+			Before:    "false",
+			After:     "true",
+			Timestamp: time.Unix(0, 0).UTC(),
+		},
+		Stake: 1_500_000,
+	}
+}
+
+func TestWebhookSink_SignsPayload(t *testing.T) {
+	const secret = "shh"
+
+	var gotSig string
+	var gotBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSig = r.Header.Get("X-Hyperliquid-Signature")
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, secret)
+	if err := sink.Send(context.Background(), testPayload()); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(gotBody)
+	wantSig := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	if gotSig != wantSig {
+		t.Errorf("signature = %q, want %q", gotSig, wantSig)
+	}
+}
+
+func TestWebhookSink_NonSuccessStatusIsError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, "")
+	if err := sink.Send(context.Background(), testPayload()); err == nil {
+		t.Fatal("expected an error for a 500 response, got nil")
+	}
+}
+
+func TestSlackSink_FormatsEvent(t *testing.T) {
+	var body map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sink := NewSlackSink(server.URL)
+	if err := sink.Send(context.Background(), testPayload()); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	if !strings.Contains(body["text"], "test-validator") || !strings.Contains(body["text"], "jailed") {
+		t.Errorf("slack text missing expected fields: %q", body["text"])
+	}
+}
+
+func TestPagerDutySink_SendsRoutingKey(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sink := &PagerDutySink{routingKey: "routing-key", client: server.Client()}
+	sink.pagerDutyEventsURLOverride = server.URL
+
+	if err := sink.Send(context.Background(), testPayload()); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	if body["routing_key"] != "routing-key" {
+		t.Errorf("routing_key = %v, want %q", body["routing_key"], "routing-key")
+	}
+	if body["event_action"] != "trigger" {
+		t.Errorf("event_action = %v, want %q", body["event_action"], "trigger")
+	}
+}
@@ -0,0 +1,57 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SlackSink posts the event as a Slack incoming-webhook message.
+type SlackSink struct {
+	url    string
+	client *http.Client
+}
+
+func NewSlackSink(url string) *SlackSink {
+	return &SlackSink{url: url, client: &http.Client{Timeout: sinkRequestTimeout}}
+}
+
+func (s *SlackSink) Name() string {
+	return fmt.Sprintf("slack:%s", s.url)
+}
+
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+func (s *SlackSink) Send(ctx context.Context, payload Payload) error {
+	text := fmt.Sprintf(":rotating_light: validator `%s` (%s) *%s*: `%s` -> `%s` (stake %.2f)",
+		payload.Name, payload.Validator, payload.Type, payload.Before, payload.After, payload.Stake)
+	if payload.BlockHeight > 0 {
+		text += fmt.Sprintf(" at block %d", payload.BlockHeight)
+	}
+
+	body, err := json.Marshal(slackMessage{Text: text})
+	if err != nil {
+		return fmt.Errorf("marshaling slack payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating slack request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending slack message: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("slack webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
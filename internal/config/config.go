@@ -0,0 +1,113 @@
+// Package config defines the exporter's runtime configuration. Fields that
+// have a zero-value fallback document it; the fallback is applied by the
+// consuming package, so an operator can leave a whole section unset and
+// get sane defaults.
+package config
+
+import "time"
+
+// Config is the exporter's top-level configuration.
+type Config struct {
+	// Chain selects which network to monitor, e.g. "mainnet" or
+	// "testnet". It picks the default validator API endpoint when
+	// Validator.APIEndpoints isn't set.
+	Chain string
+
+	Validator   ValidatorConfig
+	History     HistoryConfig
+	Performance PerformanceConfig
+	Alerts      AlertsConfig
+}
+
+// ValidatorConfig controls how the validator monitor polls the validator
+// API.
+type ValidatorConfig struct {
+	// APIEndpoints overrides the built-in per-chain endpoint list.
+	// Leave empty to use the exporter's default for Chain.
+	APIEndpoints []string
+
+	// PollInterval is the fixed polling interval. Defaults to 5 minutes
+	// when zero and PollCron is also empty.
+	PollInterval time.Duration
+
+	// PollCron is a github.com/robfig/cron/v3 standard expression. When
+	// set, it takes precedence over PollInterval.
+	PollCron string
+
+	// EndpointCooldown is how long a failed API endpoint is skipped
+	// before being retried. Defaults to 1 minute when zero.
+	EndpointCooldown time.Duration
+}
+
+// HistoryConfig controls validator event/snapshot persistence.
+type HistoryConfig struct {
+	// BoltPath is the local BoltDB file used when MongoURI is empty.
+	// Defaults to "validator_history.db".
+	BoltPath string
+
+	// MongoURI, when set, switches the history store to MongoDB
+	// instead of the local BoltDB file.
+	MongoURI      string
+	MongoDatabase string
+
+	// Retention is how long persisted events are kept before the
+	// cleanup job prunes them. Defaults to 30 days.
+	Retention time.Duration
+
+	// StakeChangeThresholdAbs and StakeChangeThresholdRel gate when a
+	// stake move between polls is reported as a stake_changed event.
+	// Either may be left at zero; if both are zero, a 1% relative
+	// threshold is used.
+	StakeChangeThresholdAbs float64
+	StakeChangeThresholdRel float64
+}
+
+// PerformanceConfig controls the rolling nRecentBlocks performance
+// tracker.
+type PerformanceConfig struct {
+	// SampleRetention bounds how long samples are kept in the rolling
+	// window. Defaults to 24 hours.
+	SampleRetention time.Duration
+
+	// UnderperformingZScoreThreshold is the z-score at or below which a
+	// sample counts toward the consecutive-underperformance streak.
+	// Defaults to -1.5.
+	UnderperformingZScoreThreshold float64
+
+	// UnderperformingConsecutiveSamples is how many consecutive
+	// underperforming samples trigger the underperforming counter.
+	// Defaults to 3.
+	UnderperformingConsecutiveSamples int
+}
+
+// AlertsConfig declares the alert sinks validator events fan out to.
+type AlertsConfig struct {
+	Sinks []AlertSinkConfig
+}
+
+// AlertSinkConfig declaratively configures one alert sink.
+type AlertSinkConfig struct {
+	// Type selects the sink implementation: "webhook", "slack", or
+	// "pagerduty".
+	Type string
+
+	// URL is the destination for webhook and slack sinks; unused for
+	// pagerduty.
+	URL string
+
+	// Secret is the HMAC signing secret for webhook sinks, or the
+	// routing key for pagerduty sinks.
+	Secret string
+
+	// Filter is an expr-lang expression (e.g. `event in ["jailed"] and
+	// stake > 1000000`) gating which events this sink receives. Empty
+	// matches everything.
+	Filter string
+
+	// RateLimitPerSecond caps how many events per second are delivered
+	// to this sink. Defaults to 1.
+	RateLimitPerSecond float64
+
+	// DryRun logs the payload instead of sending it.
+	DryRun bool
+}
@@ -0,0 +1,156 @@
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	bucketEvents      = []byte("events")
+	bucketSnapshots   = []byte("snapshots")
+	bucketPerformance = []byte("performance_samples")
+)
+
+// BoltStore is the default, zero-dependency Store backend: a single local
+// BoltDB file. It is what StartValidatorMonitor opens when no external
+// history store is configured.
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore opens (creating if necessary) a BoltDB file at path and
+// ensures the buckets used by Store exist.
+func NewBoltStore(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("opening bolt store at %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(bucketEvents); err != nil {
+			return err
+		}
+		if _, err := tx.CreateBucketIfNotExists(bucketSnapshots); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists(bucketPerformance)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("initializing bolt buckets: %w", err)
+	}
+
+	return &BoltStore{db: db}, nil
+}
+
+func (s *BoltStore) SaveEvent(ctx context.Context, event Event) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketEvents)
+		key := []byte(fmt.Sprintf("%020d-%s-%s", event.Timestamp.UnixNano(), event.Validator, event.Type))
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		return b.Put(key, data)
+	})
+}
+
+func (s *BoltStore) LoadSnapshot(ctx context.Context, chain string) ([]Snapshot, error) {
+	var snapshot []Snapshot
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketSnapshots)
+		data := b.Get([]byte(chain))
+		if data == nil {
+			return nil
+		}
+		return json.Unmarshal(data, &snapshot)
+	})
+	return snapshot, err
+}
+
+func (s *BoltStore) SaveSnapshot(ctx context.Context, chain string, snapshot []Snapshot) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketSnapshots).Put([]byte(chain), data)
+	})
+}
+
+func (s *BoltStore) WasJailedAt(ctx context.Context, validator string, at time.Time) (bool, error) {
+	jailed := false
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(bucketEvents).Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			var event Event
+			if err := json.Unmarshal(v, &event); err != nil {
+				return err
+			}
+			if event.Validator != validator || event.Timestamp.After(at) {
+				continue
+			}
+			switch event.Type {
+			case EventJailed:
+				jailed = true
+			case EventUnjailed:
+				jailed = false
+			}
+		}
+		return nil
+	})
+	return jailed, err
+}
+
+func (s *BoltStore) PruneOlderThan(ctx context.Context, cutoff time.Time) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketEvents)
+		c := b.Cursor()
+		cutoffKey := []byte(fmt.Sprintf("%020d", cutoff.UnixNano()))
+
+		var stale [][]byte
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			if string(k) >= string(cutoffKey) {
+				break
+			}
+			stale = append(stale, append([]byte(nil), k...))
+		}
+		for _, k := range stale {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *BoltStore) LoadPerformanceSamples(ctx context.Context, chain string) ([]PerformanceSample, error) {
+	var samples []PerformanceSample
+	err := s.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(bucketPerformance).Get([]byte(chain))
+		if data == nil {
+			return nil
+		}
+		return json.Unmarshal(data, &samples)
+	})
+	return samples, err
+}
+
+func (s *BoltStore) SavePerformanceSamples(ctx context.Context, chain string, samples []PerformanceSample) error {
+	data, err := json.Marshal(samples)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketPerformance).Put([]byte(chain), data)
+	})
+}
+
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,31 @@
+package history
+
+import (
+	"context"
+	"time"
+
+	"github.com/validaoxyz/hyperliquid-exporter/internal/logger"
+)
+
+// StartCleanupJob periodically prunes events older than retention from
+// store. It is intended to be started once alongside the validator
+// monitor; callers should pick retention from config.Config so operators
+// can tune how long history is kept without a code change.
+func StartCleanupJob(ctx context.Context, store Store, retention time.Duration, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				cutoff := time.Now().Add(-retention)
+				if err := store.PruneOlderThan(ctx, cutoff); err != nil {
+					logger.Error("History cleanup error: %v", err)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,145 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoStore is a Store backend for operators who want validator history
+// in an external, queryable database rather than the local BoltDB file.
+type MongoStore struct {
+	client      *mongo.Client
+	events      *mongo.Collection
+	snapshots   *mongo.Collection
+	performance *mongo.Collection
+}
+
+// mongoSnapshotDoc wraps a persisted snapshot with its chain key, since
+// MongoDB collections don't have BoltDB's single-key-per-bucket semantics.
+type mongoSnapshotDoc struct {
+	Chain    string     `bson:"chain"`
+	Snapshot []Snapshot `bson:"snapshot"`
+}
+
+// mongoPerformanceDoc is the same trick as mongoSnapshotDoc, applied to the
+// performance tracker's ring buffer.
+type mongoPerformanceDoc struct {
+	Chain   string              `bson:"chain"`
+	Samples []PerformanceSample `bson:"samples"`
+}
+
+// NewMongoStore connects to uri and targets database dbName, creating the
+// indexes Store's queries rely on.
+func NewMongoStore(ctx context.Context, uri, dbName string) (*MongoStore, error) {
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	if err != nil {
+		return nil, fmt.Errorf("connecting to mongo: %w", err)
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		return nil, fmt.Errorf("pinging mongo: %w", err)
+	}
+
+	db := client.Database(dbName)
+	events := db.Collection("validator_events")
+	snapshots := db.Collection("validator_snapshots")
+	performance := db.Collection("validator_performance_samples")
+
+	_, err = events.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "timestamp", Value: 1}}},
+		{Keys: bson.D{{Key: "validator", Value: 1}, {Key: "timestamp", Value: 1}}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating mongo indexes: %w", err)
+	}
+
+	return &MongoStore{client: client, events: events, snapshots: snapshots, performance: performance}, nil
+}
+
+func (s *MongoStore) SaveEvent(ctx context.Context, event Event) error {
+	_, err := s.events.InsertOne(ctx, event)
+	return err
+}
+
+func (s *MongoStore) LoadSnapshot(ctx context.Context, chain string) ([]Snapshot, error) {
+	var doc mongoSnapshotDoc
+	err := s.snapshots.FindOne(ctx, bson.M{"chain": chain}).Decode(&doc)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return doc.Snapshot, nil
+}
+
+func (s *MongoStore) SaveSnapshot(ctx context.Context, chain string, snapshot []Snapshot) error {
+	_, err := s.snapshots.UpdateOne(ctx,
+		bson.M{"chain": chain},
+		bson.M{"$set": mongoSnapshotDoc{Chain: chain, Snapshot: snapshot}},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+func (s *MongoStore) WasJailedAt(ctx context.Context, validator string, at time.Time) (bool, error) {
+	cur, err := s.events.Find(ctx,
+		bson.M{"validator": validator, "timestamp": bson.M{"$lte": at}},
+		options.Find().SetSort(bson.D{{Key: "timestamp", Value: 1}}),
+	)
+	if err != nil {
+		return false, err
+	}
+	defer cur.Close(ctx)
+
+	jailed := false
+	for cur.Next(ctx) {
+		var event Event
+		if err := cur.Decode(&event); err != nil {
+			return false, err
+		}
+		switch event.Type {
+		case EventJailed:
+			jailed = true
+		case EventUnjailed:
+			jailed = false
+		}
+	}
+	return jailed, cur.Err()
+}
+
+func (s *MongoStore) PruneOlderThan(ctx context.Context, cutoff time.Time) error {
+	_, err := s.events.DeleteMany(ctx, bson.M{"timestamp": bson.M{"$lt": cutoff}})
+	return err
+}
+
+func (s *MongoStore) LoadPerformanceSamples(ctx context.Context, chain string) ([]PerformanceSample, error) {
+	var doc mongoPerformanceDoc
+	err := s.performance.FindOne(ctx, bson.M{"chain": chain}).Decode(&doc)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return doc.Samples, nil
+}
+
+func (s *MongoStore) SavePerformanceSamples(ctx context.Context, chain string, samples []PerformanceSample) error {
+	_, err := s.performance.UpdateOne(ctx,
+		bson.M{"chain": chain},
+		bson.M{"$set": mongoPerformanceDoc{Chain: chain, Samples: samples}},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+func (s *MongoStore) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return s.client.Disconnect(ctx)
+}
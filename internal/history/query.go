@@ -0,0 +1,13 @@
+package history
+
+import (
+	"context"
+	"time"
+)
+
+// WasJailed is the read-API entry point other monitors use to ask "was
+// validator jailed at block-time t", without needing to know which Store
+// backend is configured.
+func WasJailed(ctx context.Context, store Store, validator string, at time.Time) (bool, error) {
+	return store.WasJailedAt(ctx, validator, at)
+}
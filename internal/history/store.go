@@ -0,0 +1,93 @@
+// Package history persists validator state-transition events so monitors
+// can answer questions like "was validator X jailed at block-time T" across
+// restarts, and so operators can audit jailing/stake history outside of the
+// Prometheus scrape window.
+package history
+
+import (
+	"context"
+	"time"
+)
+
+// EventType identifies the kind of validator state transition being recorded.
+type EventType string
+
+const (
+	EventJailed                 EventType = "jailed"
+	EventUnjailed               EventType = "unjailed"
+	EventActivated              EventType = "activated"
+	EventDeactivated            EventType = "deactivated"
+	EventStakeChanged           EventType = "stake_changed"
+	EventJoinedSet              EventType = "joined_set"
+	EventLeftSet                EventType = "left_set"
+	EventUnjailableAfterChanged EventType = "unjailable_after_changed"
+)
+
+// Event is a single validator state transition, as detected by the
+// validator monitor's diff between consecutive polls.
+type Event struct {
+	Validator   string
+	Signer      string
+	Name        string
+	Type        EventType
+	Before      string
+	After       string
+	BlockHeight int64 // 0 when unknown; the validatorSummaries API doesn't return one today.
+	Timestamp   time.Time
+}
+
+// Snapshot is the subset of ValidatorSummary fields needed to detect
+// transitions on the next poll. It is stored per chain so the monitor can
+// resume diffing from where it left off after a restart.
+type Snapshot struct {
+	Validator       string
+	Signer          string
+	Name            string
+	Stake           float64
+	IsJailed        bool
+	IsActive        bool
+	UnjailableAfter int64
+}
+
+// PerformanceSample is one poll's worth of a validator's NRecentBlocks
+// reading, kept so the performance tracker's rolling ring buffer can be
+// rebuilt across restarts.
+type PerformanceSample struct {
+	Validator     string
+	Timestamp     time.Time
+	NRecentBlocks int
+}
+
+// Store is implemented by the pluggable persistence backends (BoltDB,
+// MongoDB, ...). All methods must be safe for concurrent use.
+type Store interface {
+	// SaveEvent appends a state-transition record.
+	SaveEvent(ctx context.Context, event Event) error
+
+	// LoadSnapshot returns the last snapshot persisted for chain, so the
+	// caller can resume diffing after a restart. It returns a nil slice
+	// and no error if no snapshot has been saved yet.
+	LoadSnapshot(ctx context.Context, chain string) ([]Snapshot, error)
+
+	// SaveSnapshot overwrites the persisted snapshot for chain.
+	SaveSnapshot(ctx context.Context, chain string, snapshot []Snapshot) error
+
+	// WasJailedAt reports whether validator had an open jailed event
+	// covering instant at.
+	WasJailedAt(ctx context.Context, validator string, at time.Time) (bool, error)
+
+	// PruneOlderThan deletes events with a timestamp before cutoff.
+	PruneOlderThan(ctx context.Context, cutoff time.Time) error
+
+	// LoadPerformanceSamples returns the persisted performance ring buffer
+	// for chain, so the performance tracker can resume its rolling
+	// windows after a restart instead of needing 24h to refill them.
+	LoadPerformanceSamples(ctx context.Context, chain string) ([]PerformanceSample, error)
+
+	// SavePerformanceSamples overwrites the persisted performance ring
+	// buffer for chain.
+	SavePerformanceSamples(ctx context.Context, chain string, samples []PerformanceSample) error
+
+	// Close releases any resources held by the store.
+	Close() error
+}
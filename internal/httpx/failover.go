@@ -0,0 +1,165 @@
+// Package httpx provides a round-robin, health-aware HTTP client shared by
+// monitors that poll one of several equivalent API endpoints (the
+// validator API today, the info API and node metrics endpoints
+// eventually). Instead of every monitor rolling its own *http.Client and
+// retry loop, they configure a FailoverClient with the endpoints to try
+// and a MetricsRecorder for their own endpoint-health/latency metrics.
+package httpx
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// MetricsRecorder lets callers publish endpoint health and request
+// latency under their own metric names, so e.g. the validator monitor and
+// the info API monitor can each expose a family that matches their
+// existing metric naming instead of sharing one generic set.
+type MetricsRecorder interface {
+	SetEndpointUp(url string, up bool)
+	ObserveRequestDuration(url string, seconds float64)
+}
+
+// NopMetricsRecorder is a MetricsRecorder that does nothing, useful for
+// callers that don't need endpoint metrics (e.g. tests).
+type NopMetricsRecorder struct{}
+
+func (NopMetricsRecorder) SetEndpointUp(string, bool)             {}
+func (NopMetricsRecorder) ObserveRequestDuration(string, float64) {}
+
+type endpoint struct {
+	url string
+
+	mu             sync.Mutex
+	unhealthyUntil time.Time
+}
+
+func (e *endpoint) healthy(now time.Time) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return now.After(e.unhealthyUntil)
+}
+
+func (e *endpoint) markUnhealthy(until time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.unhealthyUntil = until
+}
+
+// FailoverClient POSTs JSON payloads to one of several equivalent base
+// URLs, round-robining across the healthy ones and marking an endpoint
+// unhealthy for a cooldown period after a non-2xx response or timeout.
+type FailoverClient struct {
+	endpoints []*endpoint
+	cooldown  time.Duration
+	metrics   MetricsRecorder
+	client    *http.Client
+
+	mu   sync.Mutex
+	next int
+}
+
+// NewFailoverClient builds a client that tries urls in round-robin order.
+// timeout bounds each individual request; cooldown is how long a failed
+// endpoint is skipped before being retried. It returns an error if urls is
+// empty, since a client with no endpoints would panic on its first use.
+func NewFailoverClient(urls []string, timeout, cooldown time.Duration, metrics MetricsRecorder) (*FailoverClient, error) {
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("no endpoints configured")
+	}
+
+	if metrics == nil {
+		metrics = NopMetricsRecorder{}
+	}
+
+	endpoints := make([]*endpoint, len(urls))
+	for i, u := range urls {
+		endpoints[i] = &endpoint{url: u}
+		metrics.SetEndpointUp(u, true)
+	}
+
+	return &FailoverClient{
+		endpoints: endpoints,
+		cooldown:  cooldown,
+		metrics:   metrics,
+		client:    &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// PostJSON POSTs payload with a JSON content type to the next healthy
+// endpoint, failing over to subsequent endpoints on error or non-2xx
+// status. It returns the response body of the first successful call.
+func (c *FailoverClient) PostJSON(ctx context.Context, path string, payload []byte) ([]byte, error) {
+	order := c.rotationOrder()
+
+	var lastErr error
+	for _, ep := range order {
+		if !ep.healthy(time.Now()) {
+			continue
+		}
+
+		body, err := c.doPost(ctx, ep, path, payload)
+		if err == nil {
+			return body, nil
+		}
+
+		lastErr = err
+		ep.markUnhealthy(time.Now().Add(c.cooldown))
+		c.metrics.SetEndpointUp(ep.url, false)
+	}
+
+	if lastErr == nil {
+		lastErr = fmt.Errorf("no healthy endpoints available")
+	}
+	return nil, fmt.Errorf("all endpoints failed: %w", lastErr)
+}
+
+func (c *FailoverClient) doPost(ctx context.Context, ep *endpoint, path string, payload []byte) ([]byte, error) {
+	start := time.Now()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ep.url+path, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("creating request for %s: %w", ep.url, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	c.metrics.ObserveRequestDuration(ep.url, time.Since(start).Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("requesting %s: %w", ep.url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("%s returned status %d", ep.url, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response from %s: %w", ep.url, err)
+	}
+
+	c.metrics.SetEndpointUp(ep.url, true)
+	return body, nil
+}
+
+// rotationOrder returns the endpoints starting from the next round-robin
+// position, so consecutive calls spread load rather than always
+// preferring endpoints[0].
+func (c *FailoverClient) rotationOrder() []*endpoint {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	n := len(c.endpoints)
+	order := make([]*endpoint, n)
+	for i := 0; i < n; i++ {
+		order[i] = c.endpoints[(c.next+i)%n]
+	}
+	c.next = (c.next + 1) % n
+	return order
+}
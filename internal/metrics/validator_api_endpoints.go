@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	validatorAPIEndpointUp = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "hl_validator_api_endpoint_up",
+		Help: "Whether the validator API endpoint answered its last request successfully (1) or not (0).",
+	}, []string{"url"})
+
+	validatorAPIRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "hl_validator_api_request_duration_seconds",
+		Help: "Latency of requests made to a validator API endpoint.",
+	}, []string{"url"})
+)
+
+// ValidatorAPIMetrics implements httpx.MetricsRecorder for the validator
+// monitor's FailoverClient, publishing endpoint health and latency under
+// the hl_validator_api_* metric family defined above.
+type ValidatorAPIMetrics struct{}
+
+func (ValidatorAPIMetrics) SetEndpointUp(url string, up bool) {
+	value := 0.0
+	if up {
+		value = 1.0
+	}
+	validatorAPIEndpointUp.WithLabelValues(url).Set(value)
+}
+
+func (ValidatorAPIMetrics) ObserveRequestDuration(url string, seconds float64) {
+	validatorAPIRequestDuration.WithLabelValues(url).Observe(seconds)
+}
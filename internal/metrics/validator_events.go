@@ -0,0 +1,18 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var validatorJailEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "hl_validator_jail_events_total",
+	Help: "Count of validator state-transition events detected by the validator monitor, labeled by event type.",
+}, []string{"validator", "signer", "name", "event"})
+
+// IncValidatorEvent records a single detected state transition for
+// validator (e.g. jailed, unjailed, stake_changed). It is called once per
+// event emitted by the validator monitor's poll-to-poll diff.
+func IncValidatorEvent(validator, signer, name, event string) {
+	validatorJailEvents.WithLabelValues(validator, signer, name, event).Inc()
+}
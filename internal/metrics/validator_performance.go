@@ -0,0 +1,83 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	validatorRecentBlocksAvg1h = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "hl_validator_recent_blocks_avg_1h",
+		Help: "Rolling 1h mean of a validator's nRecentBlocks reading.",
+	}, []string{"validator", "signer", "name"})
+
+	validatorRecentBlocksAvg6h = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "hl_validator_recent_blocks_avg_6h",
+		Help: "Rolling 6h mean of a validator's nRecentBlocks reading.",
+	}, []string{"validator", "signer", "name"})
+
+	validatorRecentBlocksAvg24h = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "hl_validator_recent_blocks_avg_24h",
+		Help: "Rolling 24h mean of a validator's nRecentBlocks reading.",
+	}, []string{"validator", "signer", "name"})
+
+	validatorRecentBlocksStdDev24h = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "hl_validator_recent_blocks_stddev_24h",
+		Help: "Rolling 24h population standard deviation of a validator's own nRecentBlocks samples. Distinct from the cross-sectional active-set stddev behind hl_validator_performance_zscore.",
+	}, []string{"validator", "signer", "name"})
+
+	validatorParticipationRatio = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "hl_validator_participation_ratio",
+		Help: "Validator's 24h rolling nRecentBlocks mean divided by the active-set average.",
+	}, []string{"validator", "signer", "name"})
+
+	validatorPerformanceZScore = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "hl_validator_performance_zscore",
+		Help: "Validator's 24h nRecentBlocks z-score relative to the active set's cross-sectional mean and standard deviation (not the validator's own rolling stddev).",
+	}, []string{"validator", "signer", "name"})
+
+	validatorUnderperforming = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "hl_validator_underperforming_total",
+		Help: "Count of times a validator's performance z-score stayed at or below threshold for the configured number of consecutive samples.",
+	}, []string{"validator", "signer", "name"})
+)
+
+// SetValidatorRecentBlocksAvg1h publishes the rolling 1h mean of a
+// validator's nRecentBlocks reading.
+func SetValidatorRecentBlocksAvg1h(validator, signer, name string, avg float64) {
+	validatorRecentBlocksAvg1h.WithLabelValues(validator, signer, name).Set(avg)
+}
+
+// SetValidatorRecentBlocksAvg6h publishes the rolling 6h mean.
+func SetValidatorRecentBlocksAvg6h(validator, signer, name string, avg float64) {
+	validatorRecentBlocksAvg6h.WithLabelValues(validator, signer, name).Set(avg)
+}
+
+// SetValidatorRecentBlocksAvg24h publishes the rolling 24h mean.
+func SetValidatorRecentBlocksAvg24h(validator, signer, name string, avg float64) {
+	validatorRecentBlocksAvg24h.WithLabelValues(validator, signer, name).Set(avg)
+}
+
+// SetValidatorRecentBlocksStdDev24h publishes the rolling 24h population
+// standard deviation of a validator's own nRecentBlocks samples.
+func SetValidatorRecentBlocksStdDev24h(validator, signer, name string, stdDev float64) {
+	validatorRecentBlocksStdDev24h.WithLabelValues(validator, signer, name).Set(stdDev)
+}
+
+// SetValidatorParticipationRatio publishes the validator's participation
+// ratio relative to the active-set average.
+func SetValidatorParticipationRatio(validator, signer, name string, ratio float64) {
+	validatorParticipationRatio.WithLabelValues(validator, signer, name).Set(ratio)
+}
+
+// SetValidatorPerformanceZScore publishes the validator's performance
+// z-score relative to the active set.
+func SetValidatorPerformanceZScore(validator, signer, name string, z float64) {
+	validatorPerformanceZScore.WithLabelValues(validator, signer, name).Set(z)
+}
+
+// IncValidatorUnderperforming records one more consecutive-underperformance
+// alert firing for validator.
+func IncValidatorUnderperforming(validator, signer, name string) {
+	validatorUnderperforming.WithLabelValues(validator, signer, name).Inc()
+}
@@ -0,0 +1,63 @@
+package monitors
+
+import (
+	"context"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/validaoxyz/hyperliquid-exporter/internal/logger"
+)
+
+// runOnSchedule runs fn after each elapsed interval or cron tick: a cron
+// expression if cronExpr is non-empty, otherwise a fixed interval. It
+// blocks until ctx is cancelled. The first run happens after the first
+// interval/tick elapses, matching the ticker-based behavior this replaces.
+func runOnSchedule(ctx context.Context, interval time.Duration, cronExpr string, fn func()) {
+	if cronExpr != "" {
+		runOnCronSchedule(ctx, cronExpr, fn)
+		return
+	}
+	runOnFixedSchedule(ctx, interval, fn)
+}
+
+func runOnFixedSchedule(ctx context.Context, interval time.Duration, fn func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			fn()
+		}
+	}
+}
+
+// runOnCronSchedule drives fn from a github.com/robfig/cron/v3 schedule,
+// mirroring how the dappnode validator-monitoring listener composes cron
+// jobs at startup.
+func runOnCronSchedule(ctx context.Context, cronExpr string, fn func()) {
+	schedule, err := cron.ParseStandard(cronExpr)
+	if err != nil {
+		logger.Error("Invalid cron expression %q, falling back to 5m fixed interval: %v", cronExpr, err)
+		runOnFixedSchedule(ctx, 5*time.Minute, fn)
+		return
+	}
+
+	now := time.Now()
+	next := schedule.Next(now)
+
+	for {
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+			fn()
+			next = schedule.Next(time.Now())
+		}
+	}
+}
@@ -1,19 +1,26 @@
 package monitors
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 	"time"
 
+	"github.com/validaoxyz/hyperliquid-exporter/internal/alerts"
 	"github.com/validaoxyz/hyperliquid-exporter/internal/config"
+	"github.com/validaoxyz/hyperliquid-exporter/internal/history"
+	"github.com/validaoxyz/hyperliquid-exporter/internal/httpx"
 	"github.com/validaoxyz/hyperliquid-exporter/internal/logger"
 	"github.com/validaoxyz/hyperliquid-exporter/internal/metrics"
 )
 
+// defaultValidatorAPIEndpoints is used when the operator hasn't configured
+// cfg.Validator.APIEndpoints for the active chain.
+var defaultValidatorAPIEndpoints = map[string][]string{
+	"mainnet": {"https://api.hyperliquid.xyz"},
+	"testnet": {"https://api.hyperliquid-testnet.xyz"},
+}
+
 type ValidatorSummary struct {
 	Validator       string  `json:"validator"`
 	Signer          string  `json:"signer"`
@@ -27,54 +34,99 @@ type ValidatorSummary struct {
 }
 
 func StartValidatorMonitor(ctx context.Context, cfg config.Config, errCh chan<- error) {
+	store, err := openHistoryStore(ctx, cfg)
+	if err != nil {
+		errCh <- fmt.Errorf("opening validator history store: %w", err)
+		return
+	}
+
+	retention := cfg.History.Retention
+	if retention <= 0 {
+		retention = 30 * 24 * time.Hour
+	}
+	history.StartCleanupJob(ctx, store, retention, time.Hour)
+
+	previous, err := store.LoadSnapshot(ctx, cfg.Chain)
+	if err != nil {
+		logger.Error("Failed to load previous validator snapshot, starting with an empty one: %v", err)
+	}
+
+	perf := newPerformanceTracker(cfg)
+	if err := perf.loadFromStore(ctx, store, cfg.Chain); err != nil {
+		logger.Error("Failed to load previous performance samples, starting with empty ones: %v", err)
+	}
+
+	client, err := newValidatorAPIClient(cfg)
+	if err != nil {
+		errCh <- fmt.Errorf("configuring validator API client: %w", err)
+		return
+	}
+
+	dispatcher := alerts.NewDispatcher(cfg)
+	dispatcher.Start(ctx)
+
+	interval := cfg.Validator.PollInterval
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+
 	go func() {
-		ticker := time.NewTicker(5 * time.Minute)
-		defer ticker.Stop()
-
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case <-ticker.C:
-				if err := updateValidatorMetrics(ctx, cfg); err != nil {
-					logger.Error("Validator monitor error: %v", err)
-					errCh <- err
-				}
+		defer store.Close()
+
+		runOnSchedule(ctx, interval, cfg.Validator.PollCron, func() {
+			if err := updateValidatorMetrics(ctx, cfg, client, store, &previous, perf, dispatcher); err != nil {
+				logger.Error("Validator monitor error: %v", err)
+				errCh <- err
 			}
-		}
+		})
 	}()
 }
 
-func updateValidatorMetrics(ctx context.Context, cfg config.Config) error {
-	client := &http.Client{Timeout: 10 * time.Second}
-	
-	// Déterminer l'URL de l'API en fonction de la chaîne
-	var apiURL string
-	if cfg.Chain == "mainnet" {
-		apiURL = "https://api.hyperliquid.xyz/info"
-	} else {
-		apiURL = "https://api.hyperliquid-testnet.xyz/info"
-	}
-	
-	payload := []byte(`{"type": "validatorSummaries"}`)
+// newValidatorAPIClient builds the failover client for the validator API,
+// using the operator-configured endpoint list for the active chain or
+// falling back to the single hardcoded endpoint this monitor used before.
+// It errors out if no endpoints are configured or known for cfg.Chain,
+// rather than returning a client that panics on first use.
+func newValidatorAPIClient(cfg config.Config) (*httpx.FailoverClient, error) {
+	endpoints := cfg.Validator.APIEndpoints
+	if len(endpoints) == 0 {
+		endpoints = defaultValidatorAPIEndpoints[cfg.Chain]
+	}
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("no validator API endpoints configured for chain %q", cfg.Chain)
+	}
 
-	logger.Debug("Making request to validator API")
+	cooldown := cfg.Validator.EndpointCooldown
+	if cooldown <= 0 {
+		cooldown = time.Minute
+	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", apiURL, bytes.NewBuffer(payload))
-	if err != nil {
-		return fmt.Errorf("error creating request: %w", err)
+	return httpx.NewFailoverClient(endpoints, 10*time.Second, cooldown, metrics.ValidatorAPIMetrics{})
+}
+
+// openHistoryStore picks the history.Store backend configured by the
+// operator, defaulting to a local BoltDB file so the monitor works out of
+// the box with no extra infrastructure.
+func openHistoryStore(ctx context.Context, cfg config.Config) (history.Store, error) {
+	if cfg.History.MongoURI != "" {
+		return history.NewMongoStore(ctx, cfg.History.MongoURI, cfg.History.MongoDatabase)
 	}
-	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := client.Do(req)
-	if err != nil {
-		return fmt.Errorf("error making request: %w", err)
+	path := cfg.History.BoltPath
+	if path == "" {
+		path = "validator_history.db"
 	}
-	defer resp.Body.Close()
+	return history.NewBoltStore(path)
+}
 
-	body, err := io.ReadAll(resp.Body)
+func updateValidatorMetrics(ctx context.Context, cfg config.Config, client *httpx.FailoverClient, store history.Store, previous *[]history.Snapshot, perf *performanceTracker, dispatcher *alerts.Dispatcher) error {
+	payload := []byte(`{"type": "validatorSummaries"}`)
+
+	logger.Debug("Making request to validator API")
+
+	body, err := client.PostJSON(ctx, "/info", payload)
 	if err != nil {
-		return fmt.Errorf("error reading response body: %w", err)
+		return fmt.Errorf("error making request: %w", err)
 	}
 
 	var summaries []ValidatorSummary
@@ -84,6 +136,29 @@ func updateValidatorMetrics(ctx context.Context, cfg config.Config) error {
 		return fmt.Errorf("error unmarshaling response: %w", err)
 	}
 
+	stakeByValidator := make(map[string]float64, len(summaries))
+	for _, s := range summaries {
+		stakeByValidator[s.Validator] = s.Stake
+	}
+
+	current := toSnapshot(summaries)
+	for _, event := range diffSnapshots(*previous, current, cfg.History.StakeChangeThresholdAbs, cfg.History.StakeChangeThresholdRel) {
+		metrics.IncValidatorEvent(event.Validator, event.Signer, event.Name, string(event.Type))
+		if err := store.SaveEvent(ctx, event); err != nil {
+			logger.Error("Failed to persist validator event %s for %s: %v", event.Type, event.Validator, err)
+		}
+		dispatcher.Dispatch(ctx, event, stakeByValidator[event.Validator])
+	}
+	if err := store.SaveSnapshot(ctx, cfg.Chain, current); err != nil {
+		logger.Error("Failed to persist validator snapshot: %v", err)
+	}
+	*previous = current
+
+	perf.record(summaries, time.Now())
+	if err := perf.persist(ctx, store, cfg.Chain); err != nil {
+		logger.Error("Failed to persist validator performance samples: %v", err)
+	}
+
 	totalStake := 0.0
 	jailedStake := 0.0
 	notJailedStake := 0.0
@@ -134,3 +209,124 @@ func updateValidatorMetrics(ctx context.Context, cfg config.Config) error {
 
 	return nil
 }
+
+func toSnapshot(summaries []ValidatorSummary) []history.Snapshot {
+	snapshot := make([]history.Snapshot, len(summaries))
+	for i, s := range summaries {
+		snapshot[i] = history.Snapshot{
+			Validator:       s.Validator,
+			Signer:          s.Signer,
+			Name:            s.Name,
+			Stake:           s.Stake,
+			IsJailed:        s.IsJailed,
+			IsActive:        s.IsActive,
+			UnjailableAfter: s.UnjailableAfter,
+		}
+	}
+	return snapshot
+}
+
+// diffSnapshots compares two consecutive polls and returns the events that
+// transitioned. previous is nil when no snapshot has been persisted yet
+// (first poll after a cold start), in which case no events are emitted for
+// that poll since there is nothing to compare against. A non-nil empty
+// previous is diffed normally, so every current validator is joined_set.
+func diffSnapshots(previous, current []history.Snapshot, stakeThresholdAbs, stakeThresholdRel float64) []history.Event {
+	if previous == nil {
+		return nil
+	}
+
+	if stakeThresholdAbs == 0 && stakeThresholdRel == 0 {
+		stakeThresholdRel = defaultStakeChangeThresholdRel
+	}
+
+	now := time.Now()
+	byValidator := make(map[string]history.Snapshot, len(previous))
+	for _, s := range previous {
+		byValidator[s.Validator] = s
+	}
+
+	var events []history.Event
+	seen := make(map[string]bool, len(current))
+
+	for _, curr := range current {
+		seen[curr.Validator] = true
+		prev, existed := byValidator[curr.Validator]
+
+		event := func(t history.EventType, before, after string) {
+			events = append(events, history.Event{
+				Validator: curr.Validator,
+				Signer:    curr.Signer,
+				Name:      curr.Name,
+				Type:      t,
+				Before:    before,
+				After:     after,
+				Timestamp: now,
+			})
+		}
+
+		if !existed {
+			event(history.EventJoinedSet, "", "")
+			continue
+		}
+
+		if prev.IsJailed && !curr.IsJailed {
+			event(history.EventUnjailed, "true", "false")
+		} else if !prev.IsJailed && curr.IsJailed {
+			event(history.EventJailed, "false", "true")
+		}
+
+		if prev.IsActive && !curr.IsActive {
+			event(history.EventDeactivated, "true", "false")
+		} else if !prev.IsActive && curr.IsActive {
+			event(history.EventActivated, "false", "true")
+		}
+
+		if prev.UnjailableAfter != curr.UnjailableAfter {
+			event(history.EventUnjailableAfterChanged,
+				fmt.Sprintf("%d", prev.UnjailableAfter), fmt.Sprintf("%d", curr.UnjailableAfter))
+		}
+
+		if stakeChanged(prev.Stake, curr.Stake, stakeThresholdAbs, stakeThresholdRel) {
+			event(history.EventStakeChanged, fmt.Sprintf("%f", prev.Stake), fmt.Sprintf("%f", curr.Stake))
+		}
+	}
+
+	for _, prev := range previous {
+		if !seen[prev.Validator] {
+			events = append(events, history.Event{
+				Validator: prev.Validator,
+				Signer:    prev.Signer,
+				Name:      prev.Name,
+				Type:      history.EventLeftSet,
+				Timestamp: now,
+			})
+		}
+	}
+
+	return events
+}
+
+// stakeChanged reports whether the move from before to after reaches
+// either the absolute or the relative threshold. A zero threshold
+// disables that check.
+func stakeChanged(before, after, absThreshold, relThreshold float64) bool {
+	delta := after - before
+	if delta < 0 {
+		delta = -delta
+	}
+	if delta == 0 {
+		return false
+	}
+	if absThreshold > 0 && delta >= absThreshold {
+		return true
+	}
+	if relThreshold > 0 && before > 0 && delta/before >= relThreshold {
+		return true
+	}
+	return false
+}
+
+// defaultStakeChangeThresholdRel is used when the operator leaves both
+// config.Config.History.StakeChangeThresholdAbs and ...Rel unset.
+const defaultStakeChangeThresholdRel = 0.01
@@ -0,0 +1,258 @@
+package monitors
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/validaoxyz/hyperliquid-exporter/internal/config"
+	"github.com/validaoxyz/hyperliquid-exporter/internal/history"
+	"github.com/validaoxyz/hyperliquid-exporter/internal/logger"
+	"github.com/validaoxyz/hyperliquid-exporter/internal/metrics"
+)
+
+const (
+	perfWindow1h  = time.Hour
+	perfWindow6h  = 6 * time.Hour
+	perfWindow24h = 24 * time.Hour
+)
+
+// perfSample is one poll's nRecentBlocks reading for a single validator.
+type perfSample struct {
+	Timestamp     time.Time
+	NRecentBlocks int
+}
+
+// performanceTracker keeps a bounded, time-windowed ring buffer of
+// nRecentBlocks samples per validator and derives rolling performance
+// gauges from it. It is intentionally separate from the jail/stake event
+// diffing in validator_api_monitor.go: the two features share a poll but
+// not a data model.
+type performanceTracker struct {
+	mu      sync.Mutex
+	samples map[string][]perfSample
+
+	retention time.Duration
+
+	underperformThreshold float64
+	underperformSamples   int
+	underperformStreak    map[string]int
+}
+
+// newPerformanceTracker builds a tracker from config.Config.Performance,
+// falling back to sane defaults when the operator hasn't set them.
+func newPerformanceTracker(cfg config.Config) *performanceTracker {
+	retention := cfg.Performance.SampleRetention
+	if retention <= 0 {
+		retention = perfWindow24h
+	}
+
+	threshold := cfg.Performance.UnderperformingZScoreThreshold
+	if threshold == 0 {
+		threshold = -1.5
+	}
+
+	consecutive := cfg.Performance.UnderperformingConsecutiveSamples
+	if consecutive <= 0 {
+		consecutive = 3
+	}
+
+	return &performanceTracker{
+		samples:               make(map[string][]perfSample),
+		retention:             retention,
+		underperformThreshold: threshold,
+		underperformSamples:   consecutive,
+		underperformStreak:    make(map[string]int),
+	}
+}
+
+// loadFromStore rebuilds the in-memory ring buffer from whatever was
+// persisted for chain, so rolling windows don't need 24h to refill after a
+// restart.
+func (t *performanceTracker) loadFromStore(ctx context.Context, store history.Store, chain string) error {
+	persisted, err := store.LoadPerformanceSamples(ctx, chain)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, p := range persisted {
+		t.samples[p.Validator] = append(t.samples[p.Validator], perfSample{
+			Timestamp:     p.Timestamp,
+			NRecentBlocks: p.NRecentBlocks,
+		})
+	}
+	return nil
+}
+
+// persist flattens the ring buffer back into history.PerformanceSample
+// records for store.SavePerformanceSamples.
+func (t *performanceTracker) persist(ctx context.Context, store history.Store, chain string) error {
+	t.mu.Lock()
+	flat := make([]history.PerformanceSample, 0)
+	for validator, samples := range t.samples {
+		for _, s := range samples {
+			flat = append(flat, history.PerformanceSample{
+				Validator:     validator,
+				Timestamp:     s.Timestamp,
+				NRecentBlocks: s.NRecentBlocks,
+			})
+		}
+	}
+	t.mu.Unlock()
+
+	return store.SavePerformanceSamples(ctx, chain, flat)
+}
+
+// record appends this poll's nRecentBlocks reading for every validator,
+// prunes samples older than the configured retention, and publishes the
+// rolling mean, the validator's own rolling stddev, the participation
+// ratio, the cross-sectional z-score, and the underperforming counter.
+//
+// Two distinct standard deviations are in play here, intentionally: each
+// validator's own 24h rolling stddev (published on its own, from its own
+// sample history, mirroring the rolling mean) and the active set's
+// cross-sectional stddev computed by activeSetStats (used only to derive
+// the z-score, since "how far is this validator from its peers right now"
+// needs a peer distribution, not a single validator's history).
+func (t *performanceTracker) record(summaries []ValidatorSummary, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, s := range summaries {
+		buf := append(t.samples[s.Validator], perfSample{Timestamp: now, NRecentBlocks: s.NRecentBlocks})
+		t.samples[s.Validator] = pruneOlderThan(buf, now.Add(-t.retention))
+	}
+
+	avg24h := make(map[string]float64, len(summaries))
+	for _, s := range summaries {
+		if !s.IsActive {
+			continue
+		}
+		avg24h[s.Validator] = windowMean(t.samples[s.Validator], now, perfWindow24h)
+	}
+	setMean, setStdDev := activeSetStats(avg24h)
+
+	for _, s := range summaries {
+		samples := t.samples[s.Validator]
+
+		mean1h := windowMean(samples, now, perfWindow1h)
+		mean6h := windowMean(samples, now, perfWindow6h)
+		mean24h := windowMean(samples, now, perfWindow24h)
+		stdDev24h := windowStdDev(samples, now, perfWindow24h)
+
+		metrics.SetValidatorRecentBlocksAvg1h(s.Validator, s.Signer, s.Name, mean1h)
+		metrics.SetValidatorRecentBlocksAvg6h(s.Validator, s.Signer, s.Name, mean6h)
+		metrics.SetValidatorRecentBlocksAvg24h(s.Validator, s.Signer, s.Name, mean24h)
+		metrics.SetValidatorRecentBlocksStdDev24h(s.Validator, s.Signer, s.Name, stdDev24h)
+
+		if setMean == 0 {
+			continue
+		}
+		participation := mean24h / setMean
+		metrics.SetValidatorParticipationRatio(s.Validator, s.Signer, s.Name, participation)
+
+		var zScore float64
+		if setStdDev > 0 {
+			zScore = (mean24h - setMean) / setStdDev
+		}
+		metrics.SetValidatorPerformanceZScore(s.Validator, s.Signer, s.Name, zScore)
+
+		if setStdDev > 0 && zScore <= t.underperformThreshold {
+			t.underperformStreak[s.Validator]++
+			if t.underperformStreak[s.Validator] >= t.underperformSamples {
+				metrics.IncValidatorUnderperforming(s.Validator, s.Signer, s.Name)
+				t.underperformStreak[s.Validator] = 0
+				logger.Info("Validator %s underperforming: zscore=%.2f over %d consecutive samples", s.Validator, zScore, t.underperformSamples)
+			}
+		} else {
+			t.underperformStreak[s.Validator] = 0
+		}
+	}
+}
+
+// pruneOlderThan drops samples with a timestamp before cutoff, preserving
+// order (oldest first).
+func pruneOlderThan(samples []perfSample, cutoff time.Time) []perfSample {
+	i := 0
+	for i < len(samples) && samples[i].Timestamp.Before(cutoff) {
+		i++
+	}
+	if i == 0 {
+		return samples
+	}
+	return append([]perfSample(nil), samples[i:]...)
+}
+
+// windowMean averages the NRecentBlocks readings within [now-window, now].
+func windowMean(samples []perfSample, now time.Time, window time.Duration) float64 {
+	cutoff := now.Add(-window)
+	sum, count := 0.0, 0
+	for _, s := range samples {
+		if s.Timestamp.Before(cutoff) {
+			continue
+		}
+		sum += float64(s.NRecentBlocks)
+		count++
+	}
+	if count == 0 {
+		return 0
+	}
+	return sum / float64(count)
+}
+
+// windowStdDev is the population standard deviation of the NRecentBlocks
+// readings within [now-window, now], mirroring windowMean.
+func windowStdDev(samples []perfSample, now time.Time, window time.Duration) float64 {
+	cutoff := now.Add(-window)
+	var values []float64
+	for _, s := range samples {
+		if s.Timestamp.Before(cutoff) {
+			continue
+		}
+		values = append(values, float64(s.NRecentBlocks))
+	}
+	if len(values) == 0 {
+		return 0
+	}
+
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+
+	variance := 0.0
+	for _, v := range values {
+		d := v - mean
+		variance += d * d
+	}
+	variance /= float64(len(values))
+
+	return math.Sqrt(variance)
+}
+
+// activeSetStats returns the mean and population standard deviation of
+// the active set's 24h rolling averages.
+func activeSetStats(avg24h map[string]float64) (mean, stdDev float64) {
+	if len(avg24h) == 0 {
+		return 0, 0
+	}
+
+	sum := 0.0
+	for _, v := range avg24h {
+		sum += v
+	}
+	mean = sum / float64(len(avg24h))
+
+	variance := 0.0
+	for _, v := range avg24h {
+		d := v - mean
+		variance += d * d
+	}
+	variance /= float64(len(avg24h))
+
+	return mean, math.Sqrt(variance)
+}